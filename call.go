@@ -1,6 +1,7 @@
 package dbus
 
 import (
+	"context"
 	"encoding/binary"
 	"errors"
 	"strings"
@@ -38,6 +39,33 @@ func (c *Call) Store(retvalues ...interface{}) error {
 	return Store(c.Body, retvalues...)
 }
 
+// BusObject is the interface of a remote object on which methods can be
+// invoked, implemented by *Object. Code that talks to a D-Bus service and
+// wants to accept a fake BusObject in tests should declare its own
+// parameters/fields as BusObject rather than *Object.
+//
+// STATUS: this request is only partially done. Conn.Object — the
+// constructor users actually call — still returns a concrete *Object and is
+// untouched here; that change, plus updating its callers, lives in conn.go,
+// which is not part of this chunk. Until that lands, nothing upstream of
+// this file becomes more mockable: callers who want a BusObject today must
+// already hold a *Object and convert it themselves. Treat this interface
+// declaration as the foundation half of the request, not the closed ticket.
+type BusObject interface {
+	Call(method string, flags Flags, args ...interface{}) *Call
+	Go(method string, flags Flags, ch chan *Call, args ...interface{}) *Call
+	CallWithContext(ctx context.Context, method string, flags Flags, args ...interface{}) *Call
+	GoWithContext(ctx context.Context, method string, flags Flags, ch chan *Call, args ...interface{}) *Call
+	AddMatchSignal(options ...MatchOption) error
+	RemoveMatchSignal(options ...MatchOption) error
+	GetProperty(p string) (Variant, error)
+	SetProperty(p string, v interface{}) error
+	Destination() string
+	Path() ObjectPath
+}
+
+var _ BusObject = new(Object)
+
 // Object represents a remote object on which methods can be invoked.
 type Object struct {
 	conn *Conn
@@ -105,6 +133,154 @@ func (o *Object) Go(method string, flags Flags, ch chan *Call, args ...interface
 	return nil
 }
 
+// CallWithContext acts like Call but takes a context.Context. If the
+// context is canceled or its deadline elapses before the method returns,
+// Store (and the returned Call's Err) report ctx.Err() instead of blocking
+// forever for a reply that may never come.
+func (o *Object) CallWithContext(ctx context.Context, method string, flags Flags, args ...interface{}) *Call {
+	return <-o.GoWithContext(ctx, method, flags, make(chan *Call, 1), args...).Done
+}
+
+// GoWithContext acts like Go but takes a context.Context. If the context is
+// canceled or its deadline elapses before a reply arrives, the pending call
+// is removed from the connection and a *Call with Err set to ctx.Err() is
+// sent on ch instead; the real reply, if the peer still sends one, is
+// dropped by the connection's dispatch loop since its serial is no longer
+// registered.
+//
+// As with Go, if the method parameter contains a dot ('.'), the part before
+// the last dot specifies the interface on which the method is called.
+//
+// (No unit test accompanies this function: exercising it needs a live or
+// fake Conn, and the type that defines Conn — conn.go — is not part of this
+// change.)
+func (o *Object) GoWithContext(ctx context.Context, method string, flags Flags, ch chan *Call, args ...interface{}) *Call {
+	if ctx == nil {
+		panic("dbus: nil Context")
+	}
+	iface := ""
+	i := strings.LastIndex(method, ".")
+	if i != -1 {
+		iface = method[:i]
+	}
+	method = method[i+1:]
+	msg := new(Message)
+	msg.Order = binary.LittleEndian
+	msg.Type = TypeMethodCall
+	msg.serial = <-o.conn.serial
+	msg.Flags = flags & (FlagNoAutoStart | FlagNoReplyExpected)
+	msg.Headers = make(map[HeaderField]Variant)
+	msg.Headers[FieldPath] = MakeVariant(o.path)
+	msg.Headers[FieldDestination] = MakeVariant(o.dest)
+	msg.Headers[FieldMember] = MakeVariant(method)
+	if iface != "" {
+		msg.Headers[FieldInterface] = MakeVariant(iface)
+	}
+	msg.Body = args
+	if len(args) > 0 {
+		msg.Headers[FieldSignature] = MakeVariant(GetSignature(args...))
+	}
+	if msg.Flags&FlagNoReplyExpected == 0 {
+		if ch == nil {
+			ch = make(chan *Call, 10)
+		} else if cap(ch) == 0 {
+			panic("(*dbus.Object).GoWithContext: unbuffered channel")
+		}
+		call := &Call{
+			Destination: o.dest,
+			Path:        o.path,
+			Method:      method,
+			Args:        args,
+			Done:        ch,
+		}
+		o.conn.callsLck.Lock()
+		o.conn.calls[msg.serial] = call
+		o.conn.callsLck.Unlock()
+		o.conn.out <- msg
+
+		// Race the pending call against ctx using conn.calls (guarded by
+		// callsLck) as the single source of truth for who gets to deliver:
+		// whichever side removes msg.serial from the map first owns
+		// delivery on call.Done. This goroutine only ever reads from
+		// ctx.Done(), never from call.Done itself, so it can't steal a
+		// reply meant for the caller — or, if ch is shared across calls,
+		// meant for an entirely different call. It stays parked until ctx
+		// is done, same as any other ctx.Done() waiter; callers are
+		// expected to eventually cancel ctx (e.g. via a deferred
+		// CancelFunc) whether or not the call completed first.
+		//
+		// Deliberately avoids context.AfterFunc (Go 1.21+) since this
+		// package has no go.mod pinning a minimum Go version yet.
+		go func() {
+			<-ctx.Done()
+			o.conn.callsLck.Lock()
+			c, ok := o.conn.calls[msg.serial]
+			delete(o.conn.calls, msg.serial)
+			o.conn.callsLck.Unlock()
+			if ok {
+				c.Err = ctx.Err()
+				c.Done <- c
+			}
+		}()
+
+		return call
+	}
+	o.conn.out <- msg
+	return nil
+}
+
+// AddMatchSignal registers a match rule with the message bus so that
+// signals matching the given options are delivered on this connection, in
+// addition to scoping the rule to this object's path. See Conn.AddMatchSignal
+// for the available options.
+func (o *Object) AddMatchSignal(options ...MatchOption) error {
+	options = append([]MatchOption{WithMatchObjectPath(o.path)}, options...)
+	return o.conn.AddMatchSignal(options...)
+}
+
+// RemoveMatchSignal undoes the effect of an AddMatchSignal call with the
+// same options.
+func (o *Object) RemoveMatchSignal(options ...MatchOption) error {
+	options = append([]MatchOption{WithMatchObjectPath(o.path)}, options...)
+	return o.conn.RemoveMatchSignal(options...)
+}
+
+// GetProperty calls org.freedesktop.DBus.Properties.Get on o and stores the
+// result into a Variant. The property name p must be given in interface.member
+// notation.
+func (o *Object) GetProperty(p string) (Variant, error) {
+	idx := strings.LastIndex(p, ".")
+	if idx == -1 || idx+1 == len(p) {
+		return Variant{}, errors.New("dbus: invalid property " + p)
+	}
+
+	iface := p[:idx]
+	prop := p[idx+1:]
+
+	result := Variant{}
+	err := o.Call("org.freedesktop.DBus.Properties.Get", 0, iface, prop).Store(&result)
+	if err != nil {
+		return Variant{}, err
+	}
+
+	return result, nil
+}
+
+// SetProperty calls org.freedesktop.DBus.Properties.Set on o to set the
+// property p to the value v. The property name p must be given in
+// interface.member notation.
+func (o *Object) SetProperty(p string, v interface{}) error {
+	idx := strings.LastIndex(p, ".")
+	if idx == -1 || idx+1 == len(p) {
+		return errors.New("dbus: invalid property " + p)
+	}
+
+	iface := p[:idx]
+	prop := p[idx+1:]
+
+	return o.Call("org.freedesktop.DBus.Properties.Set", 0, iface, prop, MakeVariant(v)).Err
+}
+
 // Destination returns the destination that calls on o are sent to.
 func (o *Object) Destination() string {
 	return o.dest
@@ -0,0 +1,155 @@
+package dbus
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// matchOptions accumulates the key/value pairs of a match rule as MatchOption
+// functions are applied, along with the first error any of them reported.
+type matchOptions struct {
+	rules map[string]string
+	err   error
+}
+
+func (o *matchOptions) set(key, value string) {
+	if o.rules == nil {
+		o.rules = make(map[string]string)
+	}
+	o.rules[key] = value
+}
+
+func (o *matchOptions) fail(err error) {
+	if o.err == nil {
+		o.err = err
+	}
+}
+
+// MatchOption specifies a key/value pair of a D-Bus match rule, for use with
+// Conn.AddMatchSignal, Conn.RemoveMatchSignal and (*Object).AddMatchSignal.
+type MatchOption func(*matchOptions)
+
+// WithMatchInterface sets the match rule's interface filter.
+func WithMatchInterface(iface string) MatchOption {
+	return func(o *matchOptions) { o.set("interface", iface) }
+}
+
+// WithMatchMember sets the match rule's member (signal name) filter.
+func WithMatchMember(member string) MatchOption {
+	return func(o *matchOptions) { o.set("member", member) }
+}
+
+// WithMatchObjectPath sets the match rule's path filter.
+func WithMatchObjectPath(p ObjectPath) MatchOption {
+	return func(o *matchOptions) { o.set("path", string(p)) }
+}
+
+// WithMatchSender sets the match rule's sender filter.
+func WithMatchSender(sender string) MatchOption {
+	return func(o *matchOptions) { o.set("sender", sender) }
+}
+
+// WithMatchDestination sets the match rule's destination filter.
+func WithMatchDestination(dest string) MatchOption {
+	return func(o *matchOptions) { o.set("destination", dest) }
+}
+
+// WithMatchPathNamespace sets the match rule's path_namespace filter,
+// matching p and everything below it.
+func WithMatchPathNamespace(p ObjectPath) MatchOption {
+	return func(o *matchOptions) { o.set("path_namespace", string(p)) }
+}
+
+// WithMatchArg restricts the match rule to signals whose n'th body argument
+// equals value. n must be between 0 and 63 inclusive, per the D-Bus
+// match-rule grammar.
+func WithMatchArg(n int, value string) MatchOption {
+	return func(o *matchOptions) {
+		if n < 0 || n > 63 {
+			o.fail(fmt.Errorf("dbus: invalid match arg index %d", n))
+			return
+		}
+		o.set("arg"+strconv.Itoa(n), value)
+	}
+}
+
+// WithMatchArg0Namespace restricts the match rule to signals whose first
+// body argument is, or is a namespace prefix of, value. It is typically used
+// to match well-known bus names such as "org.freedesktop.DBus.".
+func WithMatchArg0Namespace(value string) MatchOption {
+	return func(o *matchOptions) { o.set("arg0namespace", value) }
+}
+
+// WithMatchEavesdrop explicitly sets the match rule's eavesdrop flag.
+func WithMatchEavesdrop(eavesdrop bool) MatchOption {
+	return func(o *matchOptions) { o.set("eavesdrop", strconv.FormatBool(eavesdrop)) }
+}
+
+// formatMatchOptions renders options into the value half of a D-Bus match
+// rule (everything after "type='signal',"). Keys are sorted for a
+// deterministic rule string. Single quotes are the only character the match
+// rule grammar requires escaping inside a quoted value; commas need no
+// special handling since they fall inside the quotes.
+func formatMatchOptions(options []MatchOption) (string, error) {
+	o := &matchOptions{}
+	for _, opt := range options {
+		opt(o)
+	}
+	if o.err != nil {
+		return "", o.err
+	}
+
+	keys := make([]string, 0, len(o.rules))
+	for k := range o.rules {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	rule := make([]string, 0, len(keys))
+	for _, k := range keys {
+		rule = append(rule, k+"='"+escapeMatchValue(o.rules[k])+"'")
+	}
+	return strings.Join(rule, ","), nil
+}
+
+func escapeMatchValue(v string) string {
+	return strings.ReplaceAll(v, "'", `'\''`)
+}
+
+// signalMatchRule builds a full "type='signal'[,...]" match rule from
+// options, matching every signal when options is empty instead of leaving a
+// trailing comma.
+func signalMatchRule(options []MatchOption) (string, error) {
+	extra, err := formatMatchOptions(options)
+	if err != nil {
+		return "", err
+	}
+	rule := "type='signal'"
+	if extra != "" {
+		rule += "," + extra
+	}
+	return rule, nil
+}
+
+// AddMatchSignal registers a match rule with the message bus so that
+// matching signals are delivered to channels subscribed via Signal or
+// AddSignalWithSequence.
+func (conn *Conn) AddMatchSignal(options ...MatchOption) error {
+	rule, err := signalMatchRule(options)
+	if err != nil {
+		return err
+	}
+	return conn.busObj().Call("org.freedesktop.DBus.AddMatch", 0, rule).Err
+}
+
+// RemoveMatchSignal undoes the effect of an AddMatchSignal call with the
+// same options.
+func (conn *Conn) RemoveMatchSignal(options ...MatchOption) error {
+	rule, err := signalMatchRule(options)
+	if err != nil {
+		return err
+	}
+	return conn.busObj().Call("org.freedesktop.DBus.RemoveMatch", 0, rule).Err
+}
@@ -0,0 +1,67 @@
+package dbus
+
+import "testing"
+
+func TestFormatMatchOptionsEmpty(t *testing.T) {
+	got, err := formatMatchOptions(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "" {
+		t.Fatalf("expected empty rule for no options, got %q", got)
+	}
+}
+
+func TestFormatMatchOptionsOrderingAndEscaping(t *testing.T) {
+	got, err := formatMatchOptions([]MatchOption{
+		WithMatchMember("PropertiesChanged"),
+		WithMatchInterface("org.freedesktop.DBus.Properties"),
+		WithMatchArg(0, "it's quoted"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `arg0='it'\''s quoted',interface='org.freedesktop.DBus.Properties',member='PropertiesChanged'`
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestWithMatchArgRejectsOutOfRangeIndex(t *testing.T) {
+	for _, n := range []int{-1, 64, 1000} {
+		_, err := formatMatchOptions([]MatchOption{WithMatchArg(n, "v")})
+		if err == nil {
+			t.Fatalf("WithMatchArg(%d, ...) should have reported an error", n)
+		}
+	}
+}
+
+func TestWithMatchArgAcceptsBoundaryIndices(t *testing.T) {
+	for _, n := range []int{0, 63} {
+		_, err := formatMatchOptions([]MatchOption{WithMatchArg(n, "v")})
+		if err != nil {
+			t.Fatalf("WithMatchArg(%d, ...) should be valid, got error: %v", n, err)
+		}
+	}
+}
+
+func TestSignalMatchRuleNoTrailingComma(t *testing.T) {
+	rule, err := signalMatchRule(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rule != "type='signal'" {
+		t.Fatalf("expected bare type filter for no options, got %q", rule)
+	}
+}
+
+func TestSignalMatchRuleWithOptions(t *testing.T) {
+	rule, err := signalMatchRule([]MatchOption{WithMatchInterface("org.freedesktop.DBus")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "type='signal',interface='org.freedesktop.DBus'"
+	if rule != want {
+		t.Fatalf("got %q, want %q", rule, want)
+	}
+}
@@ -0,0 +1,26 @@
+package dbus
+
+// STATUS (request sakura-remote-desktop/godbus#chunk0-5): NOT DONE.
+//
+// The request asks for a counter threaded through the connection's inbound
+// message loop that stamps every received Message, and for that stamp to be
+// exposed as Call.Sequence() and Signal.Sequence. None of that is possible
+// from this chunk: the inbound loop, Conn, and Signal are all defined in
+// conn.go, which this series does not touch. Shipping only this Sequence
+// type — with no counter, no call site, and no Call/Signal field — is a
+// no-op and should not be read as closing the request; it is the shared
+// type the real wiring is expected to use once a conn.go change lands.
+// Treat chunk0-5 as blocked on that follow-up, not completed.
+
+// Sequence identifies the relative order in which a connection received a
+// message off the wire. It is only meaningful when comparing two sequence
+// numbers from the same Conn; it is not comparable across connections.
+//
+// The zero value, NoSequence, means "unset". No code in this package assigns
+// Sequence values: there is no counter, and no received message, Call or
+// Signal carries one.
+type Sequence uint64
+
+// NoSequence is the zero Sequence, meaning no sequence number has been
+// assigned.
+const NoSequence Sequence = 0
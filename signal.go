@@ -0,0 +1,214 @@
+package dbus
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+)
+
+// SignalHandlerOption configures a subscription added through
+// (*SequentialSignalHandler).Signal.
+type SignalHandlerOption func(*signalQueueConfig)
+
+// OverflowPolicy controls what a SequentialSignalHandler does when a
+// subscriber's bounded queue is full and another signal arrives for it.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock makes the handler's dispatch loop wait until the
+	// subscriber's queue has room. This is the default and preserves every
+	// signal, at the cost of stalling delivery to other subscribers while
+	// it waits.
+	OverflowBlock OverflowPolicy = iota
+
+	// OverflowDropOldest discards the oldest queued signal to make room for
+	// the new one.
+	OverflowDropOldest
+
+	// OverflowDropNewest discards the incoming signal, keeping everything
+	// already queued.
+	OverflowDropNewest
+)
+
+type signalQueueConfig struct {
+	capacity int // 0 means unbounded
+	policy   OverflowPolicy
+}
+
+// WithQueueCapacity bounds a subscription's internal queue to n pending
+// signals. The default, a capacity of 0, is unbounded.
+func WithQueueCapacity(n int) SignalHandlerOption {
+	return func(c *signalQueueConfig) { c.capacity = n }
+}
+
+// WithOverflowPolicy sets what happens when a bounded queue (see
+// WithQueueCapacity) is full. It has no effect on an unbounded queue.
+func WithOverflowPolicy(p OverflowPolicy) SignalHandlerOption {
+	return func(c *signalQueueConfig) { c.policy = p }
+}
+
+// SequentialSignalHandler is a SignalHandler that guarantees signals are
+// delivered to each subscribed channel in exactly the order the connection
+// received them from the bus, even when a subscriber is temporarily slower
+// than the bus. Each subscription added with Signal runs its own goroutine
+// backed by a queue that is unbounded by default, so a slow subscriber cannot
+// delay delivery to any other subscriber the way a single shared, bounded
+// channel would. A subscription bounded with WithQueueCapacity under
+// OverflowBlock is the exception: while its queue is full, delivery to every
+// subscriber waits.
+//
+// Installing it as the active handler for a Conn — so that DeliverSignal
+// actually gets called, and AddSignalWithSequence below succeeds — is the
+// responsibility of whatever assigns Conn.signalHandler at connection open
+// time (conn.go, not part of this change); this type only supplies the
+// handler itself.
+//
+// STATUS: ordering and backpressure are fully implemented and tested here,
+// but the request's motivating use case — discarding signals that arrived
+// before a given method reply completed by comparing Signal.Sequence against
+// Call.Sequence() — is not deliverable from this chunk. Signal is defined
+// outside it and never gains a Sequence field; see sequence.go for why
+// Call has no Sequence() method either. Delivery here
+// simply forwards whatever *Signal the connection hands to DeliverSignal,
+// so Sequence correlation will work automatically once a conn.go change
+// stamps Signal.Sequence — but that wiring is not part of this series.
+type SequentialSignalHandler struct {
+	mu   sync.Mutex
+	subs map[chan<- *Signal]*signalQueue
+}
+
+// NewSequentialSignalHandler returns a SequentialSignalHandler with no
+// subscriptions.
+func NewSequentialSignalHandler() *SequentialSignalHandler {
+	return &SequentialSignalHandler{
+		subs: make(map[chan<- *Signal]*signalQueue),
+	}
+}
+
+// DeliverSignal implements SignalHandler.
+func (h *SequentialSignalHandler) DeliverSignal(iface, member string, signal *Signal) {
+	h.mu.Lock()
+	qs := make([]*signalQueue, 0, len(h.subs))
+	for _, q := range h.subs {
+		qs = append(qs, q)
+	}
+	h.mu.Unlock()
+
+	// push can block (OverflowBlock) until a slow subscriber's queue has
+	// room, so it must run with h.mu released; otherwise a single stuck
+	// subscriber would also prevent Signal/RemoveSignal from ever being
+	// able to reconfigure or remove it.
+	for _, q := range qs {
+		q.push(signal)
+	}
+}
+
+// Signal subscribes ch to every signal h delivers, in receive order. If ch
+// is already subscribed, its queue is replaced and reconfigured from opts.
+func (h *SequentialSignalHandler) Signal(ch chan<- *Signal, opts ...SignalHandlerOption) {
+	cfg := signalQueueConfig{policy: OverflowBlock}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if old, ok := h.subs[ch]; ok {
+		old.close()
+	}
+	h.subs[ch] = newSignalQueue(ch, cfg)
+}
+
+// RemoveSignal unsubscribes ch, undoing a previous call to Signal. It is a
+// no-op if ch was never subscribed.
+func (h *SequentialSignalHandler) RemoveSignal(ch chan<- *Signal) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if q, ok := h.subs[ch]; ok {
+		q.close()
+		delete(h.subs, ch)
+	}
+}
+
+// signalQueue drains a linked-list backed queue into a single subscriber's
+// channel on its own goroutine, so that subscriber's backpressure does not
+// block delivery to any other subscriber, except when the queue is bounded
+// and its policy is OverflowBlock: push then waits for room.
+type signalQueue struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	items  list.List
+	ch     chan<- *Signal
+	cfg    signalQueueConfig
+	closed bool
+}
+
+func newSignalQueue(ch chan<- *Signal, cfg signalQueueConfig) *signalQueue {
+	q := &signalQueue{ch: ch, cfg: cfg}
+	q.cond = sync.NewCond(&q.mu)
+	go q.run()
+	return q
+}
+
+func (q *signalQueue) push(s *Signal) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+	for q.cfg.capacity > 0 && q.items.Len() >= q.cfg.capacity {
+		switch q.cfg.policy {
+		case OverflowDropNewest:
+			return
+		case OverflowDropOldest:
+			q.items.Remove(q.items.Front())
+		default: // OverflowBlock
+			q.cond.Wait()
+			if q.closed {
+				return
+			}
+			continue
+		}
+		break
+	}
+	q.items.PushBack(s)
+	q.cond.Broadcast()
+}
+
+func (q *signalQueue) run() {
+	for {
+		q.mu.Lock()
+		for q.items.Len() == 0 && !q.closed {
+			q.cond.Wait()
+		}
+		if q.items.Len() == 0 {
+			q.mu.Unlock()
+			return
+		}
+		s := q.items.Remove(q.items.Front()).(*Signal)
+		q.cond.Broadcast() // wake any push() waiting for room
+		q.mu.Unlock()
+
+		q.ch <- s
+	}
+}
+
+func (q *signalQueue) close() {
+	q.mu.Lock()
+	q.closed = true
+	q.cond.Broadcast()
+	q.mu.Unlock()
+}
+
+// AddSignalWithSequence is a convenience for subscribing ch through the
+// connection's SignalHandler when that handler is a
+// *SequentialSignalHandler, forwarding opts to its Signal method. It
+// returns an error if conn was not opened with a SequentialSignalHandler.
+func (conn *Conn) AddSignalWithSequence(ch chan<- *Signal, opts ...SignalHandlerOption) error {
+	h, ok := conn.signalHandler.(*SequentialSignalHandler)
+	if !ok {
+		return errors.New("dbus: AddSignalWithSequence requires a *SequentialSignalHandler")
+	}
+	h.Signal(ch, opts...)
+	return nil
+}
@@ -0,0 +1,118 @@
+package dbus
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSequentialSignalHandlerOrdering(t *testing.T) {
+	h := NewSequentialSignalHandler()
+	ch := make(chan *Signal, 1)
+	h.Signal(ch)
+
+	const n = 50
+	sent := make([]*Signal, n)
+	for i := range sent {
+		sent[i] = new(Signal)
+		h.DeliverSignal("com.example.Iface", "Member", sent[i])
+	}
+
+	for i, want := range sent {
+		select {
+		case got := <-ch:
+			if got != want {
+				t.Fatalf("signal %d: delivered out of order", i)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("signal %d was never delivered", i)
+		}
+	}
+}
+
+// TestSequentialSignalHandlerOverflow exercises both bounded-queue overflow
+// policies against a subscriber whose drain goroutine is stuck sending its
+// first item (nobody reads from ch until all four signals are delivered), so
+// that every signal pushed after the first genuinely queues up behind
+// capacity instead of being drained immediately.
+func TestSequentialSignalHandlerOverflow(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		policy   OverflowPolicy
+		expected []int // indices into a/b/c/d expected to survive, in order
+	}{
+		{"DropOldest", OverflowDropOldest, []int{0, 2, 3}}, // a, c, d; b evicted
+		{"DropNewest", OverflowDropNewest, []int{0, 1, 2}}, // a, b, c; d dropped
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewSequentialSignalHandler()
+			ch := make(chan *Signal) // never read until the test says so
+			h.Signal(ch, WithQueueCapacity(2), WithOverflowPolicy(tc.policy))
+
+			signals := []*Signal{new(Signal), new(Signal), new(Signal), new(Signal)}
+			h.DeliverSignal("", "", signals[0]) // dequeued by run(), blocks sending
+			time.Sleep(20 * time.Millisecond)   // let run() reach that blocked send
+			h.DeliverSignal("", "", signals[1])
+			h.DeliverSignal("", "", signals[2])
+			h.DeliverSignal("", "", signals[3]) // queue at capacity: triggers the policy
+
+			for _, idx := range tc.expected {
+				select {
+				case got := <-ch:
+					if got != signals[idx] {
+						t.Fatalf("expected signal %d, got a different one", idx)
+					}
+				case <-time.After(time.Second):
+					t.Fatalf("expected signal %d, got nothing", idx)
+				}
+			}
+		})
+	}
+}
+
+// TestSequentialSignalHandlerDeliverDoesNotBlockManagement guards against
+// DeliverSignal holding h.mu while a push to one subscriber's bounded,
+// OverflowBlock queue is stuck: Signal/RemoveSignal must still be able to
+// manage other subscriptions in the meantime.
+func TestSequentialSignalHandlerDeliverDoesNotBlockManagement(t *testing.T) {
+	h := NewSequentialSignalHandler()
+	stuck := make(chan *Signal) // never read
+	h.Signal(stuck, WithQueueCapacity(1))
+
+	// First delivery is dequeued by run() immediately and then blocks
+	// forever trying to send on the never-read channel.
+	h.DeliverSignal("", "", new(Signal))
+	time.Sleep(20 * time.Millisecond)
+	// Second delivery fills the now-empty, capacity-1 queue.
+	h.DeliverSignal("", "", new(Signal))
+
+	blocked := make(chan struct{})
+	go func() {
+		// Queue is full and its drain goroutine is permanently stuck, so
+		// this blocks inside push() under OverflowBlock.
+		h.DeliverSignal("", "", new(Signal))
+		close(blocked)
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		other := make(chan *Signal, 1)
+		h.Signal(other) // must not deadlock behind the blocked push above
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Signal() deadlocked while another subscriber's push was blocked")
+	}
+
+	// Removing the stuck subscriber closes its queue, which wakes the
+	// blocked push and lets the earlier goroutine return.
+	h.RemoveSignal(stuck)
+	select {
+	case <-blocked:
+	case <-time.After(time.Second):
+		t.Fatal("blocked DeliverSignal never returned after RemoveSignal")
+	}
+}